@@ -0,0 +1,296 @@
+// Command gohtmltopdf-server exposes HTML-to-PDF conversion as an HTTP
+// API, in the spirit of Gotenberg's Chromium module. It keeps a single
+// browser instance running for the lifetime of the process so that
+// individual requests don't pay Chromium's startup cost.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DevSymo/gohtmltopdf/pkg/htmltopdf"
+)
+
+func main() {
+	addr := flag.String("listen", ":3000", "Address to listen on")
+	browserPath := flag.String("browser", "", "Path to Chrome/Chromium executable (for airgapped environments)")
+	noDownload := flag.Bool("no-download", false, "Prevent automatic browser download (for airgapped environments)")
+	minChromeVersion := flag.Int("min-chrome-version", 0, "Reject a discovered browser below this Chromium major version")
+	flag.Parse()
+
+	converter, err := htmltopdf.New(*browserPath, *noDownload, *minChromeVersion)
+	if err != nil {
+		log.Fatalf("failed to start browser: %v", err)
+	}
+	defer converter.Close()
+
+	srv := &server{converter: converter}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/forms/chromium/convert/html", srv.handleConvertHTML)
+
+	log.Printf("gohtmltopdf-server listening on %s", *addr)
+	if err := http.ListenAndServe(*addr, mux); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type server struct {
+	converter *htmltopdf.Converter
+}
+
+// handleConvertHTML implements POST /forms/chromium/convert/html. It
+// accepts a multipart form containing an index.html file, any number of
+// additional asset files referenced by it, and form fields controlling
+// the PDF output.
+func (s *server) handleConvertHTML(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if err := r.ParseMultipartForm(32 << 20); err != nil {
+		http.Error(w, fmt.Sprintf("failed to parse form: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	workDir, err := stageFiles(r.MultipartForm)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer os.RemoveAll(workDir)
+
+	indexPath := filepath.Join(workDir, "index.html")
+	if _, err := os.Stat(indexPath); err != nil {
+		http.Error(w, "index.html is required", http.StatusBadRequest)
+		return
+	}
+
+	opts, err := optionsFromForm(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	pdf, err := s.converter.ConvertFile(r.Context(), indexPath, opts)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("conversion failed: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType(opts.Format))
+	if _, err := io.Copy(w, pdf); err != nil {
+		log.Printf("failed to write response: %v", err)
+	}
+}
+
+// contentType maps a PDFOptions.Format value to the response's
+// Content-Type, normalizing case the same way render.go's format
+// dispatch does so the header always matches the body produced.
+func contentType(format string) string {
+	switch strings.ToLower(format) {
+	case "png":
+		return "image/png"
+	case "jpeg", "jpg":
+		return "image/jpeg"
+	default:
+		return "application/pdf"
+	}
+}
+
+// stageFiles writes every uploaded file in form to a fresh temp
+// directory so relative asset references (css, images) resolve the same
+// way they would on disk.
+func stageFiles(form *multipart.Form) (string, error) {
+	dir, err := os.MkdirTemp("", "gohtmltopdf-form-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create work dir: %w", err)
+	}
+
+	for _, headers := range form.File {
+		for _, header := range headers {
+			if err := stageFile(dir, header); err != nil {
+				os.RemoveAll(dir)
+				return "", err
+			}
+		}
+	}
+	return dir, nil
+}
+
+func stageFile(dir string, header *multipart.FileHeader) error {
+	src, err := header.Open()
+	if err != nil {
+		return fmt.Errorf("failed to open uploaded file %s: %w", header.Filename, err)
+	}
+	defer src.Close()
+
+	dstPath := filepath.Join(dir, filepath.Base(header.Filename))
+	dst, err := os.Create(dstPath)
+	if err != nil {
+		return fmt.Errorf("failed to stage uploaded file %s: %w", header.Filename, err)
+	}
+	defer dst.Close()
+
+	if _, err := io.Copy(dst, src); err != nil {
+		return fmt.Errorf("failed to stage uploaded file %s: %w", header.Filename, err)
+	}
+	return nil
+}
+
+func optionsFromForm(r *http.Request) (htmltopdf.PDFOptions, error) {
+	marginTop, err := formMargin(r, "marginTop", 0.4)
+	if err != nil {
+		return htmltopdf.PDFOptions{}, err
+	}
+	marginBottom, err := formMargin(r, "marginBottom", 0.4)
+	if err != nil {
+		return htmltopdf.PDFOptions{}, err
+	}
+	marginLeft, err := formMargin(r, "marginLeft", 0.4)
+	if err != nil {
+		return htmltopdf.PDFOptions{}, err
+	}
+	marginRight, err := formMargin(r, "marginRight", 0.4)
+	if err != nil {
+		return htmltopdf.PDFOptions{}, err
+	}
+	clipRect, err := formClip(r, "clip")
+	if err != nil {
+		return htmltopdf.PDFOptions{}, err
+	}
+
+	return htmltopdf.PDFOptions{
+		Landscape:           formBool(r, "landscape"),
+		PaperSize:           formString(r, "paperSize", "A4"),
+		Scale:               formFloat(r, "scale", 1.0),
+		PrintBackground:     formBoolDefault(r, "printBackground", true),
+		MarginTop:           marginTop,
+		MarginBottom:        marginBottom,
+		MarginLeft:          marginLeft,
+		MarginRight:         marginRight,
+		HeaderTemplate:      formString(r, "headerTemplate", ""),
+		FooterTemplate:      formString(r, "footerTemplate", ""),
+		DisplayHeaderFooter: formBool(r, "displayHeaderFooter"),
+		PageRanges:          formString(r, "pageRanges", ""),
+		PreferCSSPageSize:   formBoolDefault(r, "preferCssPageSize", true),
+		WaitForSelector:     formString(r, "waitForSelector", ""),
+		WaitForJS:           formString(r, "waitForJs", ""),
+		WaitDelay:           formDuration(r, "waitDelay", 0),
+		Format:              formString(r, "format", "pdf"),
+		FullPage:            formBool(r, "fullPage"),
+		Clip:                clipRect,
+		Quality:             formInt(r, "quality", 0),
+		DeviceScaleFactor:   formFloat(r, "deviceScaleFactor", 0),
+	}, nil
+}
+
+func formString(r *http.Request, key, def string) string {
+	if v := r.FormValue(key); v != "" {
+		return v
+	}
+	return def
+}
+
+func formBool(r *http.Request, key string) bool {
+	v, _ := strconv.ParseBool(r.FormValue(key))
+	return v
+}
+
+func formBoolDefault(r *http.Request, key string, def bool) bool {
+	v := r.FormValue(key)
+	if v == "" {
+		return def
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return def
+	}
+	return b
+}
+
+// formDuration parses key as a Go duration string (e.g. "500ms", "2s").
+func formDuration(r *http.Request, key string, def time.Duration) time.Duration {
+	v := r.FormValue(key)
+	if v == "" {
+		return def
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return def
+	}
+	return d
+}
+
+// formMargin parses key as a CSS length ("1cm", "20px", a bare number of
+// inches) via htmltopdf.ParseMargin, returning def if the field is absent.
+func formMargin(r *http.Request, key string, def float64) (float64, error) {
+	v := r.FormValue(key)
+	if v == "" {
+		return def, nil
+	}
+	margin, err := htmltopdf.ParseMargin(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return margin, nil
+}
+
+func formFloat(r *http.Request, key string, def float64) float64 {
+	v := r.FormValue(key)
+	if v == "" {
+		return def
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return def
+	}
+	return f
+}
+
+func formInt(r *http.Request, key string, def int) int {
+	v := r.FormValue(key)
+	if v == "" {
+		return def
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return def
+	}
+	return n
+}
+
+// formClip parses key as "x,y,width,height" in CSS pixels, matching the
+// CLI's -clip flag. An empty field yields a nil rect.
+func formClip(r *http.Request, key string) (*htmltopdf.ClipRect, error) {
+	v := r.FormValue(key)
+	if v == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(v, ",")
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("invalid %s %q: expected \"x,y,width,height\"", key, v)
+	}
+
+	fields := make([]float64, 4)
+	for i, p := range parts {
+		f, err := strconv.ParseFloat(strings.TrimSpace(p), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %w", key, v, err)
+		}
+		fields[i] = f
+	}
+
+	return &htmltopdf.ClipRect{X: fields[0], Y: fields[1], Width: fields[2], Height: fields[3]}, nil
+}
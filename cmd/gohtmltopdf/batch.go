@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+
+	"github.com/DevSymo/gohtmltopdf/pkg/htmltopdf"
+)
+
+// batchJob is one entry in a batch manifest: an HTML input and, unless
+// the batch is being merged, the PDF it should be written to.
+type batchJob struct {
+	Input  string `json:"input"`
+	Output string `json:"output"`
+}
+
+// runBatch converts every job named by manifest (a JSON file or a
+// directory of .html files) using a single shared browser instance, up
+// to parallel conversions at a time. If merge is set, the resulting
+// PDFs are concatenated into a single file at that path instead of
+// being written individually.
+func runBatch(ctx context.Context, manifest, merge string, parallel int, opts htmltopdf.PDFOptions) error {
+	jobs, err := loadManifest(manifest)
+	if err != nil {
+		return err
+	}
+	if len(jobs) == 0 {
+		return fmt.Errorf("no input files found in %s", manifest)
+	}
+	if parallel < 1 {
+		parallel = 1
+	}
+
+	converter, err := htmltopdf.New(opts.BrowserPath, opts.NoDownload, opts.BrowserMinVersion)
+	if err != nil {
+		return err
+	}
+	defer converter.Close()
+
+	results := make([][]byte, len(jobs))
+	errs := make([]error, len(jobs))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, parallel)
+
+	for i, job := range jobs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, job batchJob) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			defer func() {
+				if r := recover(); r != nil {
+					errs[i] = fmt.Errorf("%s: panic during conversion: %v", job.Input, r)
+				}
+			}()
+
+			pdf, err := converter.ConvertFile(ctx, job.Input, opts)
+			if err != nil {
+				errs[i] = fmt.Errorf("%s: %w", job.Input, err)
+				return
+			}
+			data, err := io.ReadAll(pdf)
+			if err != nil {
+				errs[i] = fmt.Errorf("%s: %w", job.Input, err)
+				return
+			}
+
+			if merge == "" {
+				if err := os.WriteFile(job.Output, data, 0644); err != nil {
+					errs[i] = fmt.Errorf("%s: %w", job.Input, err)
+				}
+				return
+			}
+			results[i] = data
+		}(i, job)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	if merge == "" {
+		fmt.Printf("Successfully converted %d file(s)\n", len(jobs))
+		return nil
+	}
+
+	if err := mergePDFs(results, merge); err != nil {
+		return fmt.Errorf("failed to merge PDFs: %w", err)
+	}
+	fmt.Printf("Successfully converted and merged %d file(s) into '%s'\n", len(jobs), merge)
+	return nil
+}
+
+// loadManifest reads a batch job list from a JSON file, or derives one
+// from every .html file in a directory (each written alongside its
+// input with a .pdf extension).
+func loadManifest(path string) ([]batchJob, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read batch manifest: %w", err)
+	}
+
+	if info.IsDir() {
+		matches, err := filepath.Glob(filepath.Join(path, "*.html"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to glob %s: %w", path, err)
+		}
+		jobs := make([]batchJob, len(matches))
+		for i, input := range matches {
+			jobs[i] = batchJob{
+				Input:  input,
+				Output: strings.TrimSuffix(input, filepath.Ext(input)) + ".pdf",
+			}
+		}
+		return jobs, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read batch manifest: %w", err)
+	}
+	var jobs []batchJob
+	if err := json.Unmarshal(data, &jobs); err != nil {
+		return nil, fmt.Errorf("failed to parse batch manifest: %w", err)
+	}
+	return jobs, nil
+}
+
+// mergePDFs concatenates pdfs, in order, into a single PDF at outputPath.
+func mergePDFs(pdfs [][]byte, outputPath string) error {
+	tmpFiles := make([]string, 0, len(pdfs))
+	defer func() {
+		for _, f := range tmpFiles {
+			os.Remove(f)
+		}
+	}()
+
+	for i, data := range pdfs {
+		f, err := os.CreateTemp("", fmt.Sprintf("gohtmltopdf-merge-%d-*.pdf", i))
+		if err != nil {
+			return err
+		}
+		if _, err := f.Write(data); err != nil {
+			f.Close()
+			return err
+		}
+		f.Close()
+		tmpFiles = append(tmpFiles, f.Name())
+	}
+
+	return api.MergeCreateFile(tmpFiles, outputPath, false, nil)
+}
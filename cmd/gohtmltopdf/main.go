@@ -0,0 +1,209 @@
+// Command gohtmltopdf converts HTML files to PDF, PNG or JPEG using a
+// headless Chromium instance, either one file at a time or in batch.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DevSymo/gohtmltopdf/pkg/htmltopdf"
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s -input <html-file> -output <pdf-file>\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+
+	inputFile := flag.String("input", "", "Path to the input HTML file (required)")
+	outputFile := flag.String("output", "", "Path for the output PDF file (required)")
+	landscape := flag.Bool("landscape", false, "Set page orientation to landscape")
+	paperSize := flag.String("paper", "A4", "Paper size (A4, Letter, Legal, etc.)")
+	scale := flag.Float64("scale", 1.0, "Scale factor for rendering (default: 1.0)")
+	printBackground := flag.Bool("background", true, "Print background colors and images")
+	browserPath := flag.String("browser", "", "Path to Chrome/Chromium executable (for airgapped environments)")
+	noDownload := flag.Bool("no-download", false, "Prevent automatic browser download (for airgapped environments)")
+	minChromeVersion := flag.Int("min-chrome-version", 0, "Reject a discovered browser below this Chromium major version")
+	timeout := flag.Int("timeout", 60, "Timeout in seconds for the conversion process")
+	marginTop := flag.String("margin-top", "0.4in", "Top margin, e.g. \"0.4in\", \"1cm\", \"20px\"")
+	marginBottom := flag.String("margin-bottom", "0.4in", "Bottom margin, e.g. \"0.4in\", \"1cm\", \"20px\"")
+	marginLeft := flag.String("margin-left", "0.4in", "Left margin, e.g. \"0.4in\", \"1cm\", \"20px\"")
+	marginRight := flag.String("margin-right", "0.4in", "Right margin, e.g. \"0.4in\", \"1cm\", \"20px\"")
+	headerTemplate := flag.String("header-template", "", "HTML header template, or a path to a file containing one")
+	footerTemplate := flag.String("footer-template", "", "HTML footer template, or a path to a file containing one")
+	displayHeaderFooter := flag.Bool("display-header-footer", false, "Render the header/footer templates")
+	pageRanges := flag.String("page-ranges", "", "Pages to print, e.g. \"1-3,5\" (default: all pages)")
+	preferCSSPageSize := flag.Bool("prefer-css-page-size", true, "Prefer @page size CSS rules over -paper/-landscape")
+	waitForSelector := flag.String("wait-for-selector", "", "CSS selector to wait for before rendering")
+	waitForJS := flag.String("wait-for-js", "", "JS expression polled until truthy before rendering")
+	waitDelay := flag.Duration("wait-delay", 0, "Unconditional delay after load before rendering, e.g. 500ms")
+	batch := flag.String("batch", "", "Path to a JSON manifest or a directory of .html files to convert in batch")
+	merge := flag.String("merge", "", "Merge batch output into a single PDF at this path, instead of writing one PDF per input")
+	parallel := flag.Int("parallel", 1, "Number of conversions to run concurrently in batch mode")
+	format := flag.String("format", "pdf", "Output format: pdf, png, or jpeg")
+	fullPage := flag.Bool("full-page", false, "Capture the full scrollable page (image formats only)")
+	clip := flag.String("clip", "", "Clip the screenshot to \"x,y,width,height\" in CSS pixels (image formats only)")
+	quality := flag.Int("quality", 0, "JPEG quality, 0-100 (jpeg format only)")
+	deviceScaleFactor := flag.Float64("device-scale-factor", 0, "Override device pixel ratio, e.g. 2 (image formats only)")
+	flag.Parse()
+
+	clipRect, err := parseClip(*clip)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	marginTopIn, err := htmltopdf.ParseMargin(*marginTop)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	marginBottomIn, err := htmltopdf.ParseMargin(*marginBottom)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	marginLeftIn, err := htmltopdf.ParseMargin(*marginLeft)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	marginRightIn, err := htmltopdf.ParseMargin(*marginRight)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	opts := htmltopdf.PDFOptions{
+		Landscape:           *landscape,
+		PaperSize:           *paperSize,
+		Scale:               *scale,
+		PrintBackground:     *printBackground,
+		BrowserPath:         *browserPath,
+		NoDownload:          *noDownload,
+		BrowserMinVersion:   *minChromeVersion,
+		MarginTop:           marginTopIn,
+		MarginBottom:        marginBottomIn,
+		MarginLeft:          marginLeftIn,
+		MarginRight:         marginRightIn,
+		HeaderTemplate:      *headerTemplate,
+		FooterTemplate:      *footerTemplate,
+		DisplayHeaderFooter: *displayHeaderFooter,
+		PageRanges:          *pageRanges,
+		PreferCSSPageSize:   *preferCSSPageSize,
+		WaitForSelector:     *waitForSelector,
+		WaitForJS:           *waitForJS,
+		WaitDelay:           *waitDelay,
+		Format:              *format,
+		FullPage:            *fullPage,
+		Clip:                clipRect,
+		Quality:             *quality,
+		DeviceScaleFactor:   *deviceScaleFactor,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(*timeout)*time.Second)
+	defer cancel()
+
+	if *batch != "" {
+		if err := runBatch(ctx, *batch, *merge, *parallel, opts); err != nil {
+			fmt.Fprintf(os.Stderr, "Error in batch conversion: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *inputFile == "" || *outputFile == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	if _, err := os.Stat(*inputFile); os.IsNotExist(err) {
+		fmt.Fprintf(os.Stderr, "Error: Input file '%s' not found.\n", *inputFile)
+		os.Exit(1)
+	}
+
+	outputDir := filepath.Dir(*outputFile)
+	if outputDir != "" && outputDir != "." {
+		if _, err := os.Stat(outputDir); os.IsNotExist(err) {
+			if err := os.MkdirAll(outputDir, 0755); err != nil {
+				fmt.Fprintf(os.Stderr, "Error creating output directory: %v\n", err)
+				os.Exit(1)
+			}
+		}
+	}
+
+	if err := run(ctx, *inputFile, *outputFile, opts); err != nil {
+		fmt.Fprintf(os.Stderr, "Error converting HTML to PDF: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Successfully converted '%s' to '%s'\n", *inputFile, *outputFile)
+}
+
+func run(ctx context.Context, inputFile, outputFile string, opts htmltopdf.PDFOptions) error {
+	converter, err := htmltopdf.New(opts.BrowserPath, opts.NoDownload, opts.BrowserMinVersion)
+	if err != nil {
+		return err
+	}
+	defer converter.Close()
+
+	done := make(chan error, 1)
+	var pdf io.Reader
+
+	go func() {
+		r, err := converter.ConvertFile(ctx, inputFile, opts)
+		pdf = r
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return err
+		}
+	case <-ctx.Done():
+		return fmt.Errorf("operation timed out")
+	}
+
+	file, err := os.Create(outputFile)
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := io.Copy(file, pdf); err != nil {
+		return fmt.Errorf("failed to write PDF data: %w", err)
+	}
+	return nil
+}
+
+// parseClip parses a "-clip" flag value of the form "x,y,width,height"
+// into a ClipRect. An empty string yields a nil rect.
+func parseClip(value string) (*htmltopdf.ClipRect, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(value, ",")
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("invalid -clip %q: expected \"x,y,width,height\"", value)
+	}
+
+	fields := make([]float64, 4)
+	for i, p := range parts {
+		f, err := strconv.ParseFloat(strings.TrimSpace(p), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid -clip %q: %w", value, err)
+		}
+		fields[i] = f
+	}
+
+	return &htmltopdf.ClipRect{X: fields[0], Y: fields[1], Width: fields[2], Height: fields[3]}, nil
+}
@@ -0,0 +1,167 @@
+// Package browser locates a local Chromium-family browser to drive,
+// for environments where gohtmltopdf must not (or cannot) download its
+// own copy.
+package browser
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-rod/rod/lib/launcher"
+)
+
+// ErrNotFound is the sentinel wrapped by NotFoundError; use errors.Is
+// to check for it regardless of which paths were searched.
+var ErrNotFound = errors.New("browser: no compatible browser found")
+
+// envVars are consulted, in order, before any path scanning happens.
+var envVars = []string{"GOHTMLTOPDF_BROWSER", "CHROME_BIN", "PUPPETEER_EXECUTABLE_PATH"}
+
+// Options configures a browser search.
+type Options struct {
+	// Path, if set, is tried before any env var or platform search.
+	Path string
+
+	// MinVersion is the minimum acceptable Chromium major version.
+	// Zero means any version is acceptable.
+	MinVersion int
+}
+
+// Info describes a located browser binary.
+type Info struct {
+	Path    string
+	Version int
+}
+
+// NotFoundError is returned when no acceptable browser could be
+// located. It records every path that was tried so callers can surface
+// an actionable message to airgapped users instead of a generic
+// failure.
+type NotFoundError struct {
+	Searched []string
+	// TooOld maps paths that exist and run but report a version below
+	// the requested minimum, to the version they reported.
+	TooOld map[string]int
+}
+
+func (e *NotFoundError) Error() string {
+	var b strings.Builder
+	b.WriteString("no compatible browser found")
+	if len(e.TooOld) > 0 {
+		fmt.Fprintf(&b, "; found but below the minimum version: %s", formatTooOld(e.TooOld))
+	}
+	if len(e.Searched) > 0 {
+		fmt.Fprintf(&b, "; searched: %s", strings.Join(e.Searched, ", "))
+	}
+	return b.String()
+}
+
+func (e *NotFoundError) Unwrap() error {
+	return ErrNotFound
+}
+
+func formatTooOld(tooOld map[string]int) string {
+	parts := make([]string, 0, len(tooOld))
+	for path, version := range tooOld {
+		parts = append(parts, fmt.Sprintf("%s (v%d)", path, version))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Find probes opts.Path, the gohtmltopdf/Chrome/Puppeteer env vars,
+// then a set of platform-specific install locations, returning the
+// first binary that runs and meets opts.MinVersion. It returns a
+// *NotFoundError wrapping ErrNotFound if nothing qualifies.
+func Find(opts Options) (Info, error) {
+	candidates := candidatePaths(opts.Path)
+
+	searched := make([]string, 0, len(candidates))
+	tooOld := map[string]int{}
+
+	for _, path := range candidates {
+		info, ok, err := tryCandidate(path, opts.MinVersion)
+		if err != nil {
+			searched = append(searched, path)
+			continue
+		}
+		if !ok {
+			tooOld[path] = info.Version
+			continue
+		}
+		return info, nil
+	}
+
+	if path, exists := launcher.LookPath(); exists {
+		if info, ok, err := tryCandidate(path, opts.MinVersion); err == nil {
+			if ok {
+				return info, nil
+			}
+			tooOld[path] = info.Version
+		} else {
+			searched = append(searched, path)
+		}
+	}
+
+	return Info{}, &NotFoundError{Searched: searched, TooOld: tooOld}
+}
+
+// tryCandidate checks whether path exists and reports a usable
+// version. The bool return is false (with no error) when the binary
+// runs but is older than minVersion.
+func tryCandidate(path string, minVersion int) (Info, bool, error) {
+	if _, err := os.Stat(path); err != nil {
+		return Info{}, false, err
+	}
+	version, err := detectVersion(path)
+	if err != nil {
+		return Info{}, false, err
+	}
+	info := Info{Path: path, Version: version}
+	if minVersion > 0 && version < minVersion {
+		return info, false, nil
+	}
+	return info, true, nil
+}
+
+var versionPattern = regexp.MustCompile(`(\d+)\.\d+\.\d+\.\d+`)
+
+// detectVersion runs "<path> --version" and parses the Chromium major
+// version out of output like "Google Chrome 119.0.6045.105".
+func detectVersion(path string) (int, error) {
+	out, err := exec.Command(path, "--version").Output()
+	if err != nil {
+		return 0, fmt.Errorf("failed to run %s --version: %w", path, err)
+	}
+
+	match := versionPattern.FindStringSubmatch(string(out))
+	if match == nil {
+		return 0, fmt.Errorf("could not parse version from %s --version output: %q", path, strings.TrimSpace(string(out)))
+	}
+
+	version, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0, fmt.Errorf("could not parse version from %s --version output: %q", path, strings.TrimSpace(string(out)))
+	}
+	return version, nil
+}
+
+// candidatePaths builds the full, ordered list of paths to try: an
+// explicit override, the supported env vars, then platform-specific
+// install locations.
+func candidatePaths(explicit string) []string {
+	var paths []string
+	if explicit != "" {
+		paths = append(paths, explicit)
+	}
+	for _, env := range envVars {
+		if v := os.Getenv(env); v != "" {
+			paths = append(paths, v)
+		}
+	}
+	return append(paths, platformPaths()...)
+}
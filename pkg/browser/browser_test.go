@@ -0,0 +1,102 @@
+package browser
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+// fakeVersionBinary writes an executable shell script at dir/name that
+// prints output to stdout, mimicking a browser's "--version" behavior.
+func fakeVersionBinary(t *testing.T, dir, name, output string) string {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("fake binaries in this test are POSIX shell scripts")
+	}
+
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte("#!/bin/sh\necho '"+output+"'\n"), 0o755); err != nil {
+		t.Fatalf("failed to write fake binary: %v", err)
+	}
+	return path
+}
+
+func TestDetectVersion(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := []struct {
+		name    string
+		output  string
+		want    int
+		wantErr bool
+	}{
+		{name: "google chrome", output: "Google Chrome 119.0.6045.105", want: 119},
+		{name: "chromium", output: "Chromium 117.0.5938.132", want: 117},
+		{name: "microsoft edge", output: "Microsoft Edge 120.0.2210.91", want: 120},
+		{name: "unparseable", output: "not a version string", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := fakeVersionBinary(t, dir, tt.name, tt.output)
+			got, err := detectVersion(path)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("detectVersion(%q) = %d, nil; want error", tt.output, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("detectVersion(%q) returned error: %v", tt.output, err)
+			}
+			if got != tt.want {
+				t.Fatalf("detectVersion(%q) = %d; want %d", tt.output, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNotFoundErrorMessage(t *testing.T) {
+	err := &NotFoundError{
+		Searched: []string{"/usr/bin/google-chrome", "/usr/bin/chromium"},
+	}
+	got := err.Error()
+	want := "no compatible browser found; searched: /usr/bin/google-chrome, /usr/bin/chromium"
+	if got != want {
+		t.Fatalf("Error() = %q; want %q", got, want)
+	}
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("errors.Is(err, ErrNotFound) = false; want true")
+	}
+}
+
+func TestNotFoundErrorMessageWithTooOld(t *testing.T) {
+	err := &NotFoundError{
+		TooOld: map[string]int{"/usr/bin/chromium": 90},
+	}
+	got := err.Error()
+	want := "no compatible browser found; found but below the minimum version: /usr/bin/chromium (v90)"
+	if got != want {
+		t.Fatalf("Error() = %q; want %q", got, want)
+	}
+}
+
+func TestCandidatePaths(t *testing.T) {
+	t.Setenv("GOHTMLTOPDF_BROWSER", "/opt/browser/chrome")
+	t.Setenv("CHROME_BIN", "")
+	t.Setenv("PUPPETEER_EXECUTABLE_PATH", "")
+
+	paths := candidatePaths("/explicit/chrome")
+
+	if len(paths) < 2 {
+		t.Fatalf("candidatePaths returned %d paths; want at least 2", len(paths))
+	}
+	if paths[0] != "/explicit/chrome" {
+		t.Fatalf("candidatePaths[0] = %q; want the explicit path first", paths[0])
+	}
+	if paths[1] != "/opt/browser/chrome" {
+		t.Fatalf("candidatePaths[1] = %q; want the env var override second", paths[1])
+	}
+}
@@ -0,0 +1,93 @@
+package browser
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// platformPaths returns the well-known install locations for
+// Chrome/Chromium/Edge/Brave across Linux, macOS, and Windows,
+// including Snap/Flatpak paths on Linux and the per-user
+// LOCALAPPDATA install on Windows.
+func platformPaths() []string {
+	switch runtime.GOOS {
+	case "darwin":
+		return darwinPaths()
+	case "windows":
+		return windowsPaths()
+	default:
+		return linuxPaths()
+	}
+}
+
+func linuxPaths() []string {
+	home, _ := os.UserHomeDir()
+	paths := []string{
+		"/usr/bin/google-chrome",
+		"/usr/bin/google-chrome-stable",
+		"/usr/bin/google-chrome-beta",
+		"/usr/bin/google-chrome-unstable",
+		"/usr/bin/chromium",
+		"/usr/bin/chromium-browser",
+		"/usr/bin/microsoft-edge",
+		"/usr/bin/microsoft-edge-stable",
+		"/usr/bin/microsoft-edge-beta",
+		"/usr/bin/brave-browser",
+		"/usr/bin/brave-browser-beta",
+		// Snap
+		"/snap/bin/chromium",
+		"/snap/bin/google-chrome",
+		// System Flatpak
+		"/var/lib/flatpak/exports/bin/com.google.Chrome",
+		"/var/lib/flatpak/exports/bin/org.chromium.Chromium",
+		"/var/lib/flatpak/exports/bin/com.brave.Browser",
+		"/var/lib/flatpak/exports/bin/com.microsoft.Edge",
+	}
+	if home != "" {
+		paths = append(paths,
+			filepath.Join(home, ".local/share/flatpak/exports/bin/com.google.Chrome"),
+			filepath.Join(home, ".local/share/flatpak/exports/bin/org.chromium.Chromium"),
+			filepath.Join(home, ".local/share/flatpak/exports/bin/com.brave.Browser"),
+			filepath.Join(home, ".local/share/flatpak/exports/bin/com.microsoft.Edge"),
+		)
+	}
+	return paths
+}
+
+func darwinPaths() []string {
+	return []string{
+		"/Applications/Google Chrome.app/Contents/MacOS/Google Chrome",
+		"/Applications/Google Chrome Beta.app/Contents/MacOS/Google Chrome Beta",
+		"/Applications/Google Chrome Canary.app/Contents/MacOS/Google Chrome Canary",
+		"/Applications/Chromium.app/Contents/MacOS/Chromium",
+		"/Applications/Microsoft Edge.app/Contents/MacOS/Microsoft Edge",
+		"/Applications/Brave Browser.app/Contents/MacOS/Brave Browser",
+	}
+}
+
+func windowsPaths() []string {
+	programFiles := os.Getenv("PROGRAMFILES")
+	programFilesX86 := os.Getenv("PROGRAMFILES(X86)")
+	localAppData := os.Getenv("LOCALAPPDATA")
+
+	var paths []string
+	for _, root := range []string{programFiles, programFilesX86} {
+		if root == "" {
+			continue
+		}
+		paths = append(paths,
+			filepath.Join(root, `Google\Chrome\Application\chrome.exe`),
+			filepath.Join(root, `Chromium\Application\chrome.exe`),
+			filepath.Join(root, `Microsoft\Edge\Application\msedge.exe`),
+			filepath.Join(root, `BraveSoftware\Brave-Browser\Application\brave.exe`),
+		)
+	}
+	if localAppData != "" {
+		paths = append(paths,
+			filepath.Join(localAppData, `Google\Chrome\Application\chrome.exe`),
+			filepath.Join(localAppData, `BraveSoftware\Brave-Browser\Application\brave.exe`),
+		)
+	}
+	return paths
+}
@@ -0,0 +1,152 @@
+// Package htmltopdf converts HTML documents to PDF using a headless
+// Chromium instance driven by rod. A Converter launches the browser once
+// and reuses it across calls, which avoids the multi-second startup cost
+// of spawning Chromium for every conversion.
+package htmltopdf
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/launcher"
+
+	"github.com/DevSymo/gohtmltopdf/pkg/browser"
+)
+
+// Converter owns a long-lived headless browser instance and converts HTML
+// to PDF through it. Create one with New and reuse it across conversions;
+// call Close when done to release the browser process.
+type Converter struct {
+	launcher *launcher.Launcher
+	browser  *rod.Browser
+}
+
+// New launches a headless browser and returns a Converter bound to it.
+// If browserPath is empty and neither noDownload nor minVersion is set,
+// rod's default discovery (including automatic download) is used.
+// Otherwise the browser package searches the environment and common
+// local install locations instead. minVersion, if non-zero, rejects any browser
+// reporting a Chromium major version below it.
+func New(browserPath string, noDownload bool, minVersion int) (*Converter, error) {
+	l, err := launchBrowser(browserPath, noDownload, minVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	controlURL, err := l.Launch()
+	if err != nil {
+		l.Cleanup()
+		return nil, fmt.Errorf("failed to launch browser: %w", err)
+	}
+
+	browser := rod.New().ControlURL(controlURL)
+	if err := browser.Connect(); err != nil {
+		l.Cleanup()
+		return nil, fmt.Errorf("failed to connect to browser: %w", err)
+	}
+
+	return &Converter{launcher: l, browser: browser}, nil
+}
+
+// Close shuts down the browser and releases the underlying process.
+func (c *Converter) Close() error {
+	err := c.browser.Close()
+	c.launcher.Cleanup()
+	return err
+}
+
+// launchBrowser resolves which browser binary to launch. A download is
+// only ever avoided when the caller passes an explicit path, sets
+// noDownload, or sets minVersion (since rod's auto-download can't be
+// version-pinned); otherwise rod's default discovery, including
+// automatic download, is used. An explicit browserPath is trusted as-is
+// (no --version validation) unless minVersion is also set, so a binary
+// with a nonstandard --version output doesn't break a previously-working
+// -browser flag.
+func launchBrowser(browserPath string, noDownload bool, minVersion int) (*launcher.Launcher, error) {
+	if browserPath != "" && minVersion == 0 {
+		return launcher.New().Bin(browserPath).Headless(true), nil
+	}
+
+	if browserPath == "" && !noDownload && minVersion == 0 {
+		return launcher.New().Headless(true), nil
+	}
+
+	info, err := browser.Find(browser.Options{Path: browserPath, MinVersion: minVersion})
+	if err != nil {
+		return nil, err
+	}
+	return launcher.New().Bin(info.Path).Headless(true), nil
+}
+
+// ConvertFile reads the HTML file at htmlPath and renders it in
+// opts.Format (PDF by default).
+func (c *Converter) ConvertFile(ctx context.Context, htmlPath string, opts PDFOptions) (io.Reader, error) {
+	absPath, err := filepath.Abs(htmlPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get absolute path: %w", err)
+	}
+	return c.convert(ctx, fileURL(absPath), opts)
+}
+
+// ConvertURL renders the page at the given URL in opts.Format (PDF by
+// default).
+func (c *Converter) ConvertURL(ctx context.Context, url string, opts PDFOptions) (io.Reader, error) {
+	return c.convert(ctx, url, opts)
+}
+
+// ConvertReader renders the HTML read from r in opts.Format (PDF by
+// default). The content is
+// staged to a temporary file so that relative asset paths resolve the
+// same way they would for ConvertFile.
+func (c *Converter) ConvertReader(ctx context.Context, r io.Reader, opts PDFOptions) (io.Reader, error) {
+	tmp, err := os.CreateTemp("", "gohtmltopdf-*.html")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return nil, fmt.Errorf("failed to stage HTML content: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return nil, fmt.Errorf("failed to stage HTML content: %w", err)
+	}
+
+	return c.ConvertFile(ctx, tmp.Name(), opts)
+}
+
+func (c *Converter) convert(ctx context.Context, target string, opts PDFOptions) (io.Reader, error) {
+	page := c.browser.Context(ctx).MustPage()
+	defer page.MustClose()
+
+	if err := page.Navigate(target); err != nil {
+		return nil, fmt.Errorf("failed to navigate to %s: %w", target, err)
+	}
+	page.MustWaitLoad()
+	page.MustWaitIdle()
+
+	if err := awaitReady(ctx, page, opts); err != nil {
+		return nil, err
+	}
+
+	return render(page, opts)
+}
+
+func fileURL(absPath string) string {
+	u := "file://" + absPath
+	if !strings.HasPrefix(u, "file:///") {
+		u = "file:///" + strings.TrimPrefix(u, "file://")
+	}
+	return u
+}
+
+func floatPtr(f float64) *float64 {
+	return &f
+}
@@ -0,0 +1,164 @@
+package htmltopdf
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PDFOptions holds configuration for PDF generation.
+type PDFOptions struct {
+	Landscape       bool
+	PaperSize       string
+	Scale           float64
+	PrintBackground bool
+	BrowserPath     string
+	NoDownload      bool
+	// BrowserMinVersion rejects any discovered browser reporting a
+	// Chromium major version below it. Zero means any version.
+	BrowserMinVersion int
+
+	// MarginTop, MarginBottom, MarginLeft, and MarginRight are page
+	// margins in inches, as required by proto.PagePrintToPDF. Use
+	// ParseMargin to convert a CSS length string ("1cm", "20px", a bare
+	// number of inches, ...) into this form.
+	MarginTop    float64
+	MarginBottom float64
+	MarginLeft   float64
+	MarginRight  float64
+
+	// HeaderTemplate and FooterTemplate are HTML strings used to
+	// generate the print header/footer, following Chrome DevTools'
+	// template classes (pageNumber, totalPages, date, title, url). Each
+	// may instead be a path to a file containing the template, which is
+	// read at conversion time. DisplayHeaderFooter must be set for
+	// either to take effect.
+	HeaderTemplate      string
+	FooterTemplate      string
+	DisplayHeaderFooter bool
+
+	// PageRanges restricts output to the given pages, e.g. "1-3,5". An
+	// empty string prints every page.
+	PageRanges string
+
+	// PreferCSSPageSize honors @page size rules in the document's CSS
+	// over PaperSize/Landscape.
+	PreferCSSPageSize bool
+
+	// WaitForSelector, if set, blocks the conversion until an element
+	// matching the CSS selector appears in the page.
+	WaitForSelector string
+
+	// WaitForJS, if set, is a JS expression polled until it evaluates
+	// truthy, for pages that signal their own readiness.
+	WaitForJS string
+
+	// WaitDelay is an unconditional delay applied after the page loads
+	// (and after WaitForSelector/WaitForJS, if also set), for content
+	// that finishes rendering shortly after it reports ready.
+	WaitDelay time.Duration
+
+	// Format selects the output type: "pdf" (the default), "png", or
+	// "jpeg". The PDF-specific fields above are ignored for image
+	// formats, and FullPage/Clip/Quality/DeviceScaleFactor below are
+	// ignored for "pdf".
+	Format string
+
+	// FullPage captures the entire scrollable page instead of just the
+	// current viewport. Ignored if Clip is set.
+	FullPage bool
+
+	// Clip, if set, restricts the screenshot to a rectangular region of
+	// the page instead of the full viewport.
+	Clip *ClipRect
+
+	// Quality is the JPEG compression quality, 0-100. Ignored for PNG.
+	Quality int
+
+	// DeviceScaleFactor overrides the page's device pixel ratio for the
+	// screenshot, e.g. 2 for a "retina" capture. Zero uses the browser
+	// default.
+	DeviceScaleFactor float64
+}
+
+// ClipRect is a rectangular region of a page, in CSS pixels, used to
+// restrict a screenshot to less than the full viewport.
+type ClipRect struct {
+	X      float64
+	Y      float64
+	Width  float64
+	Height float64
+}
+
+// paperDimensions returns the width and height, in inches, for a named
+// paper size. Unrecognized sizes fall back to Letter.
+func paperDimensions(paperSize string) (width, height float64) {
+	switch strings.ToUpper(paperSize) {
+	case "A4":
+		return 8.27, 11.69
+	case "LETTER":
+		return 8.5, 11.0
+	case "LEGAL":
+		return 8.5, 14.0
+	case "TABLOID", "LEDGER":
+		return 11.0, 17.0
+	case "A3":
+		return 11.69, 16.54
+	case "A5":
+		return 5.83, 8.27
+	default:
+		return 8.5, 11.0
+	}
+}
+
+// cssUnitsPerInch maps the CSS length units ParseMargin accepts to how
+// many of that unit make up one inch.
+var cssUnitsPerInch = map[string]float64{
+	"in": 1,
+	"cm": 2.54,
+	"mm": 25.4,
+	"px": 96,
+}
+
+// ParseMargin parses a page margin given as a CSS length ("1cm", "20px",
+// "0.5in", "10mm") or a bare number, which is interpreted as inches for
+// backward compatibility. It returns the value converted to inches, the
+// unit proto.PagePrintToPDF requires.
+func ParseMargin(value string) (float64, error) {
+	value = strings.TrimSpace(value)
+	for unit, perInch := range cssUnitsPerInch {
+		if strings.HasSuffix(value, unit) {
+			n, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(value, unit)), 64)
+			if err != nil {
+				return 0, fmt.Errorf("invalid margin %q: %w", value, err)
+			}
+			return n / perInch, nil
+		}
+	}
+	n, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid margin %q: expected a number of inches or a CSS length (in, cm, mm, px)", value)
+	}
+	return n, nil
+}
+
+// resolveTemplate returns value unchanged unless it names an existing
+// file, in which case the file's contents are returned instead. This
+// lets HeaderTemplate/FooterTemplate accept either an inline HTML
+// string or a path to one.
+func resolveTemplate(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	info, err := os.Stat(value)
+	if err != nil || info.IsDir() {
+		return value, nil
+	}
+	data, err := os.ReadFile(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to read template file %s: %w", value, err)
+	}
+	return string(data), nil
+}
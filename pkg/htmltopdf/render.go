@@ -0,0 +1,106 @@
+package htmltopdf
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/proto"
+)
+
+// render produces the converted output for a loaded page, dispatching
+// on opts.Format so the same page-loading pipeline can back both
+// HTML->PDF and HTML->image conversions.
+func render(page *rod.Page, opts PDFOptions) (io.Reader, error) {
+	switch strings.ToLower(opts.Format) {
+	case "", "pdf":
+		return renderPDF(page, opts)
+	case "png", "jpeg", "jpg":
+		return renderImage(page, opts)
+	default:
+		return nil, fmt.Errorf("unsupported format %q", opts.Format)
+	}
+}
+
+func renderPDF(page *rod.Page, opts PDFOptions) (io.Reader, error) {
+	printOpts, err := printOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	pdfData, err := page.PDF(printOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate PDF: %w", err)
+	}
+	return pdfData, nil
+}
+
+func printOptions(opts PDFOptions) (*proto.PagePrintToPDF, error) {
+	headerTemplate, err := resolveTemplate(opts.HeaderTemplate)
+	if err != nil {
+		return nil, err
+	}
+	footerTemplate, err := resolveTemplate(opts.FooterTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	width, height := paperDimensions(opts.PaperSize)
+	return &proto.PagePrintToPDF{
+		Landscape:           opts.Landscape,
+		PrintBackground:     opts.PrintBackground,
+		Scale:               floatPtr(opts.Scale),
+		PaperWidth:          floatPtr(width),
+		PaperHeight:         floatPtr(height),
+		MarginTop:           floatPtr(opts.MarginTop),
+		MarginBottom:        floatPtr(opts.MarginBottom),
+		MarginLeft:          floatPtr(opts.MarginLeft),
+		MarginRight:         floatPtr(opts.MarginRight),
+		PreferCSSPageSize:   opts.PreferCSSPageSize,
+		DisplayHeaderFooter: opts.DisplayHeaderFooter,
+		HeaderTemplate:      headerTemplate,
+		FooterTemplate:      footerTemplate,
+		PageRanges:          opts.PageRanges,
+	}, nil
+}
+
+func renderImage(page *rod.Page, opts PDFOptions) (io.Reader, error) {
+	if opts.DeviceScaleFactor > 0 {
+		override := proto.EmulationSetDeviceMetricsOverride{
+			Width:             0,
+			Height:            0,
+			DeviceScaleFactor: opts.DeviceScaleFactor,
+			Mobile:            false,
+		}
+		if err := override.Call(page); err != nil {
+			return nil, fmt.Errorf("failed to set device scale factor: %w", err)
+		}
+	}
+
+	format := proto.PageCaptureScreenshotFormatPng
+	if strings.ToLower(opts.Format) == "jpeg" || strings.ToLower(opts.Format) == "jpg" {
+		format = proto.PageCaptureScreenshotFormatJpeg
+	}
+
+	req := &proto.PageCaptureScreenshot{Format: format}
+	if opts.Quality > 0 {
+		quality := opts.Quality
+		req.Quality = &quality
+	}
+	if opts.Clip != nil {
+		req.Clip = &proto.PageViewport{
+			X:      opts.Clip.X,
+			Y:      opts.Clip.Y,
+			Width:  opts.Clip.Width,
+			Height: opts.Clip.Height,
+			Scale:  1,
+		}
+	}
+
+	data, err := page.Screenshot(opts.FullPage && opts.Clip == nil, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to capture screenshot: %w", err)
+	}
+	return bytes.NewReader(data), nil
+}
@@ -0,0 +1,64 @@
+package htmltopdf
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-rod/rod"
+)
+
+// jsPollInterval is how often WaitForJS is re-evaluated while waiting
+// for it to become truthy.
+const jsPollInterval = 100 * time.Millisecond
+
+// awaitReady blocks until the page satisfies opts' wait conditions, in
+// order: WaitForSelector, then WaitForJS, then WaitDelay. All three are
+// optional and skipped when unset. It honors ctx's deadline, which the
+// page is already bound to via Converter.convert.
+func awaitReady(ctx context.Context, page *rod.Page, opts PDFOptions) error {
+	if opts.WaitForSelector != "" {
+		if _, err := page.Element(opts.WaitForSelector); err != nil {
+			return fmt.Errorf("failed waiting for selector %q: %w", opts.WaitForSelector, err)
+		}
+	}
+
+	if opts.WaitForJS != "" {
+		if err := waitForJS(ctx, page, opts.WaitForJS); err != nil {
+			return err
+		}
+	}
+
+	if opts.WaitDelay > 0 {
+		select {
+		case <-time.After(opts.WaitDelay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+// waitForJS polls expr until it evaluates truthy, returns an error, or
+// ctx is done.
+func waitForJS(ctx context.Context, page *rod.Page, expr string) error {
+	ticker := time.NewTicker(jsPollInterval)
+	defer ticker.Stop()
+
+	for {
+		result, err := page.Eval(expr)
+		if err != nil {
+			return fmt.Errorf("failed to evaluate wait expression %q: %w", expr, err)
+		}
+		if result.Value.Bool() {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for expression %q: %w", expr, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}